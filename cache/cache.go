@@ -0,0 +1,18 @@
+package cache
+
+import (
+	"context"
+	"time"
+)
+
+// Cache is the caching boundary used by handlers. It is deliberately small
+// so tests can swap in an in-memory fake instead of spinning up Redis.
+type Cache interface {
+	Get(ctx context.Context, key string) (string, error)
+	Set(ctx context.Context, key string, value string, ttl time.Duration) error
+	// Del removes a single exact key.
+	Del(ctx context.Context, key string) error
+	// Invalidate removes every key matching pattern (e.g. "bookList:*"),
+	// used to drop all cached list pages after a write.
+	Invalidate(ctx context.Context, pattern string) error
+}
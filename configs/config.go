@@ -0,0 +1,87 @@
+package configs
+
+import (
+	"os"
+	"strings"
+	"time"
+)
+
+// Config holds the runtime configuration for the service, sourced from
+// environment variables with sensible local-development defaults.
+type Config struct {
+	MongoURI      string
+	MongoDatabase string
+	RedisAddr     string
+	RedisPassword string
+	RedisDB       int
+	StoreProvider string
+
+	// ElasticsearchURL is the address of the search cluster backing
+	// GET /books/search.
+	ElasticsearchURL string
+
+	// BookCacheTTL is how long a single book:<id> entry is cached.
+	BookCacheTTL time.Duration
+	// BookListCacheTTL is how long a bookList:<hash> page is cached.
+	BookListCacheTTL time.Duration
+
+	// AdminAllowlist holds the Tailscale login names and/or tags permitted
+	// to call the mutating /books endpoints.
+	AdminAllowlist []string
+}
+
+// Load reads configuration from the environment, falling back to defaults
+// suitable for running against a local MongoDB/Redis instance.
+func Load() *Config {
+	return &Config{
+		MongoURI:      getEnv("MONGO_URI", "mongodb://localhost:27017"),
+		MongoDatabase: getEnv("MONGO_DATABASE", "golangAPI"),
+		RedisAddr:     getEnv("REDIS_ADDR", "localhost:6379"),
+		RedisPassword: getEnv("REDIS_PASSWORD", ""),
+		RedisDB:       0,
+		StoreProvider: getEnv("STORE_PROVIDER", "mongo"),
+
+		ElasticsearchURL: getEnv("ELASTICSEARCH_URL", "http://localhost:9200"),
+
+		BookCacheTTL:     getEnvDuration("BOOK_CACHE_TTL", 5*time.Minute),
+		BookListCacheTTL: getEnvDuration("BOOK_LIST_CACHE_TTL", time.Minute),
+
+		AdminAllowlist: getEnvList("ADMIN_ALLOWLIST"),
+	}
+}
+
+func getEnv(key, fallback string) string {
+	if v, ok := os.LookupEnv(key); ok && v != "" {
+		return v
+	}
+	return fallback
+}
+
+func getEnvDuration(key string, fallback time.Duration) time.Duration {
+	v, ok := os.LookupEnv(key)
+	if !ok || v == "" {
+		return fallback
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		return fallback
+	}
+	return d
+}
+
+// getEnvList splits a comma-separated environment variable into its
+// trimmed, non-empty entries.
+func getEnvList(key string) []string {
+	raw, ok := os.LookupEnv(key)
+	if !ok || raw == "" {
+		return nil
+	}
+
+	var entries []string
+	for _, entry := range strings.Split(raw, ",") {
+		if entry = strings.TrimSpace(entry); entry != "" {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
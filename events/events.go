@@ -0,0 +1,21 @@
+// Package events defines the pub/sub boundary for broadcasting book events
+// to live subscribers, distinct from store.EventStore's durable history.
+package events
+
+import (
+	"context"
+
+	"my-gin-project/models"
+)
+
+// Publisher broadcasts a book event to any subscribers currently listening.
+type Publisher interface {
+	Publish(ctx context.Context, event models.BookEvent) error
+}
+
+// Subscriber streams book events as they're published. The returned
+// channel is closed once closeFn is called. Cancelling the context does not
+// by itself release the subscription, so callers always call closeFn.
+type Subscriber interface {
+	Subscribe(ctx context.Context) (events <-chan models.BookEvent, closeFn func() error, err error)
+}
@@ -0,0 +1,59 @@
+// Package redis implements events.Publisher and events.Subscriber on top
+// of Redis pub/sub.
+package redis
+
+import (
+	"context"
+	"encoding/json"
+
+	goredis "github.com/redis/go-redis/v9"
+
+	"my-gin-project/models"
+)
+
+const channel = "book_events"
+
+// Bus is an events.Publisher and events.Subscriber backed by a single
+// Redis pub/sub channel.
+type Bus struct {
+	client *goredis.Client
+}
+
+// New returns a Redis-backed Bus using the given client.
+func New(client *goredis.Client) *Bus {
+	return &Bus{client: client}
+}
+
+func (b *Bus) Publish(ctx context.Context, event models.BookEvent) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	return b.client.Publish(ctx, channel, payload).Err()
+}
+
+func (b *Bus) Subscribe(ctx context.Context) (<-chan models.BookEvent, func() error, error) {
+	sub := b.client.Subscribe(ctx, channel)
+	if _, err := sub.Receive(ctx); err != nil {
+		_ = sub.Close()
+		return nil, nil, err
+	}
+
+	out := make(chan models.BookEvent)
+	go func() {
+		defer close(out)
+		for msg := range sub.Channel() {
+			var event models.BookEvent
+			if err := json.Unmarshal([]byte(msg.Payload), &event); err != nil {
+				continue
+			}
+			select {
+			case out <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out, sub.Close, nil
+}
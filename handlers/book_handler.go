@@ -0,0 +1,341 @@
+// Package handlers contains the HTTP handlers for the book catalog. They
+// depend only on interfaces (store.Store, store.EventStore, cache.Cache,
+// events.Publisher/Subscriber and search.Indexer), never on a concrete
+// MongoDB, Redis or Elasticsearch client, so they can be unit-tested
+// against fakes.
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"golang.org/x/sync/singleflight"
+
+	"my-gin-project/cache"
+	"my-gin-project/events"
+	"my-gin-project/middleware"
+	"my-gin-project/models"
+	"my-gin-project/search"
+	"my-gin-project/store"
+)
+
+const (
+	bookListCacheKey          = "bookList"
+	bookListInvalidatePattern = bookListCacheKey + ":*"
+)
+
+// Config holds the handler-tunable settings that don't belong to any single
+// dependency, namely cache TTLs.
+type Config struct {
+	BookTTL     time.Duration
+	BookListTTL time.Duration
+}
+
+// BookHandler serves the /books routes.
+type BookHandler struct {
+	Store     store.Store
+	Cache     cache.Cache
+	Events    store.EventStore
+	Publisher events.Publisher
+	Indexer   search.Indexer
+	Config    Config
+
+	// group collapses concurrent cache misses for the same key into a
+	// single MongoDB lookup, so a cold cache under load doesn't stampede
+	// the database.
+	group singleflight.Group
+}
+
+// New returns a BookHandler backed by the given store, cache, event store,
+// event publisher and search indexer.
+func New(s store.Store, c cache.Cache, eventStore store.EventStore, publisher events.Publisher, indexer search.Indexer, cfg Config) *BookHandler {
+	return &BookHandler{Store: s, Cache: c, Events: eventStore, Publisher: publisher, Indexer: indexer, Config: cfg}
+}
+
+// booksResponse is the payload for GET /books, carrying enough metadata for
+// the client to render paging controls.
+type booksResponse struct {
+	Books  []models.Book `json:"books"`
+	Total  int64         `json:"total"`
+	Limit  int64         `json:"limit"`
+	Offset int64         `json:"offset"`
+}
+
+func (h *BookHandler) GetBooks(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	opts := parseListOptions(c)
+	cacheKey := listCacheKey(opts)
+
+	if cached, err := h.Cache.Get(ctx, cacheKey); err == nil {
+		var resp booksResponse
+		if err := json.Unmarshal([]byte(cached), &resp); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to unmarshal book data"})
+			return
+		}
+		c.JSON(http.StatusOK, resp)
+		return
+	}
+
+	v, err, _ := h.group.Do(cacheKey, func() (interface{}, error) {
+		return h.Store.GetAll(ctx, opts)
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get books"})
+		return
+	}
+	result := v.(*store.ListResult)
+
+	resp := booksResponse{Books: result.Books, Total: result.Total, Limit: opts.Limit, Offset: opts.Offset}
+	h.cacheBookList(ctx, cacheKey, resp)
+	c.JSON(http.StatusOK, resp)
+}
+
+func (h *BookHandler) GetBook(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	id := c.Param("id")
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid book ID"})
+		return
+	}
+
+	if cached, err := h.Cache.Get(ctx, bookCacheKey(id)); err == nil {
+		var book models.Book
+		if err := json.Unmarshal([]byte(cached), &book); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to unmarshal book data"})
+			return
+		}
+		c.JSON(http.StatusOK, book)
+		return
+	}
+
+	v, err, _ := h.group.Do(bookCacheKey(id), func() (interface{}, error) {
+		return h.Store.Get(ctx, objectID)
+	})
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Book not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get book"})
+		return
+	}
+	book := v.(*models.Book)
+
+	h.cacheBook(ctx, id, book)
+	c.JSON(http.StatusOK, book)
+}
+
+func (h *BookHandler) GetBookByISBN(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	book, err := h.Store.GetByISBN(ctx, c.Param("isbn"))
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Book not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get book"})
+		return
+	}
+
+	c.JSON(http.StatusOK, book)
+}
+
+// SearchBooks handles GET /books/search?q=..., ranking books by relevance
+// over title/authors/publisher via the configured search.Indexer.
+func (h *BookHandler) SearchBooks(c *gin.Context) {
+	if h.Indexer == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Search is not available"})
+		return
+	}
+
+	query := c.Query("q")
+	if query == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Missing required query parameter q"})
+		return
+	}
+
+	result, err := h.Indexer.Search(c.Request.Context(), query)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to search books"})
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+func (h *BookHandler) CreateBook(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var newBook models.Book
+	if err := c.ShouldBindJSON(&newBook); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := h.Store.Create(ctx, &newBook); err != nil {
+		if errors.Is(err, store.ErrDuplicateISBN) {
+			c.JSON(http.StatusConflict, gin.H{"error": "A book with this ISBN already exists"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to save book"})
+		return
+	}
+
+	h.cacheBook(ctx, newBook.ID.Hex(), &newBook)
+	h.invalidateBookList(ctx)
+	h.indexBook(ctx, newBook)
+	h.emitEvent(ctx, models.EventCreated, newBook.ID.Hex(), actor(c), &newBook)
+	c.JSON(http.StatusCreated, newBook)
+}
+
+func (h *BookHandler) UpdateBook(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	id := c.Param("id")
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid book ID"})
+		return
+	}
+
+	var updatedBook models.Book
+	if err := c.ShouldBindJSON(&updatedBook); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := h.Store.Update(ctx, objectID, &updatedBook); err != nil {
+		if errors.Is(err, store.ErrDuplicateISBN) {
+			c.JSON(http.StatusConflict, gin.H{"error": "A book with this ISBN already exists"})
+			return
+		}
+		if errors.Is(err, store.ErrNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Book not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update book"})
+		return
+	}
+
+	h.cacheBook(ctx, id, &updatedBook)
+	h.invalidateBookList(ctx)
+	h.indexBook(ctx, updatedBook)
+	h.emitEvent(ctx, models.EventUpdated, id, actor(c), &updatedBook)
+	c.JSON(http.StatusOK, updatedBook)
+}
+
+func (h *BookHandler) DeleteBook(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	id := c.Param("id")
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid book ID"})
+		return
+	}
+
+	if err := h.Store.Delete(ctx, objectID); err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Book not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete book"})
+		return
+	}
+
+	if err := h.Cache.Del(ctx, bookCacheKey(id)); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete book data from cache"})
+		return
+	}
+	h.invalidateBookList(ctx)
+	if h.Indexer != nil {
+		if err := h.Indexer.Delete(ctx, id); err != nil {
+			log.Printf("failed to delete book %s from search index: %v", id, err)
+		}
+	}
+
+	h.emitEvent(ctx, models.EventDeleted, id, actor(c), nil)
+	c.JSON(http.StatusOK, gin.H{"message": "Book deleted"})
+}
+
+func (h *BookHandler) cacheBook(ctx context.Context, id string, book *models.Book) {
+	if data, err := json.Marshal(book); err == nil {
+		_ = h.Cache.Set(ctx, bookCacheKey(id), string(data), h.Config.BookTTL)
+	}
+}
+
+func (h *BookHandler) cacheBookList(ctx context.Context, key string, resp booksResponse) {
+	if data, err := json.Marshal(resp); err == nil {
+		_ = h.Cache.Set(ctx, key, string(data), h.Config.BookListTTL)
+	}
+}
+
+// invalidateBookList drops every cached bookList:<hash> page so writes are
+// immediately visible on the next GET /books, regardless of which
+// filter/sort/page combination was cached.
+func (h *BookHandler) invalidateBookList(ctx context.Context) {
+	_ = h.Cache.Invalidate(ctx, bookListInvalidatePattern)
+}
+
+// indexBook synchronously upserts book into the search index so it's
+// immediately findable via GET /books/search.
+func (h *BookHandler) indexBook(ctx context.Context, book models.Book) {
+	if h.Indexer != nil {
+		if err := h.Indexer.Upsert(ctx, book); err != nil {
+			log.Printf("failed to index book %s: %v", book.ID.Hex(), err)
+		}
+	}
+}
+
+func bookCacheKey(id string) string {
+	return "book:" + id
+}
+
+// emitEvent records a book event in the durable audit stream and publishes
+// it for live subscribers. Diff may be nil (e.g. for deletes).
+func (h *BookHandler) emitEvent(ctx context.Context, eventType models.EventType, bookID, actor string, diff interface{}) {
+	var rawDiff json.RawMessage
+	if diff != nil {
+		if data, err := json.Marshal(diff); err == nil {
+			rawDiff = data
+		}
+	}
+
+	event := models.BookEvent{
+		Type:      eventType,
+		BookID:    bookID,
+		Actor:     actor,
+		Timestamp: time.Now(),
+		Diff:      rawDiff,
+	}
+
+	if h.Events != nil {
+		_ = h.Events.Append(ctx, &event)
+	}
+	if h.Publisher != nil {
+		_ = h.Publisher.Publish(ctx, event)
+	}
+}
+
+// actor resolves the identity to attribute a write to. Admin routes run
+// middleware.RequireTailscaleIdentity, which stores the resolved identity
+// in the gin context; anywhere that isn't the case falls back to a
+// placeholder.
+func actor(c *gin.Context) string {
+	if v, ok := c.Get(middleware.ActorContextKey); ok {
+		if identity, ok := v.(string); ok && identity != "" {
+			return identity
+		}
+	}
+	return "anonymous"
+}
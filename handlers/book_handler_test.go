@@ -0,0 +1,96 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"my-gin-project/models"
+)
+
+func newTestHandler() (*BookHandler, *fakeStore) {
+	s := newFakeStore()
+	c := newFakeCache()
+	h := New(s, c, nil, nil, nil, Config{BookTTL: time.Minute, BookListTTL: time.Minute})
+	return h, s
+}
+
+// performRequest drives a handler directly with a synthetic gin.Context,
+// the way these handlers are meant to be tested per chunk0-1: against the
+// store.Store/cache.Cache interfaces, with no real MongoDB or Redis.
+func performRequest(fn gin.HandlerFunc, method, path string, body []byte, params gin.Params) *httptest.ResponseRecorder {
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(method, path, bytes.NewReader(body))
+	c.Params = params
+	fn(c)
+	return w
+}
+
+func TestCreateBook_DuplicateISBNReturnsConflict(t *testing.T) {
+	h, s := newTestHandler()
+	existing := models.Book{ISBN: "9780140449136", Title: "Existing", Authors: []string{"A"}}
+	if err := s.Create(nil, &existing); err != nil {
+		t.Fatalf("seed create failed: %v", err)
+	}
+
+	body, _ := json.Marshal(models.Book{ISBN: "9780140449136", Title: "New", Authors: []string{"B"}})
+	w := performRequest(h.CreateBook, http.MethodPost, "/books", body, nil)
+
+	if w.Code != http.StatusConflict {
+		t.Fatalf("expected 409, got %d: %s", w.Code, w.Body.String())
+	}
+}
+
+func TestUpdateBook_MissingIDReturnsNotFound(t *testing.T) {
+	h, _ := newTestHandler()
+	id := primitive.NewObjectID()
+
+	body, _ := json.Marshal(models.Book{ISBN: "9780140449136", Title: "Updated", Authors: []string{"A"}})
+	w := performRequest(h.UpdateBook, http.MethodPut, "/books/"+id.Hex(), body, gin.Params{{Key: "id", Value: id.Hex()}})
+
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("expected 404, got %d: %s", w.Code, w.Body.String())
+	}
+}
+
+func TestGetBooks_ListCacheInvalidatedOnCreate(t *testing.T) {
+	h, s := newTestHandler()
+	seed := models.Book{ISBN: "9780140449136", Title: "Seed", Authors: []string{"A"}}
+	if err := s.Create(nil, &seed); err != nil {
+		t.Fatalf("seed create failed: %v", err)
+	}
+
+	w := performRequest(h.GetBooks, http.MethodGet, "/books", nil, nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", w.Code, w.Body.String())
+	}
+	var first booksResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &first); err != nil {
+		t.Fatalf("unmarshal failed: %v", err)
+	}
+	if first.Total != 1 {
+		t.Fatalf("expected 1 cached book, got %d", first.Total)
+	}
+
+	newBook, _ := json.Marshal(models.Book{ISBN: "9780201633610", Title: "New", Authors: []string{"B"}})
+	if w2 := performRequest(h.CreateBook, http.MethodPost, "/books", newBook, nil); w2.Code != http.StatusCreated {
+		t.Fatalf("expected 201, got %d: %s", w2.Code, w2.Body.String())
+	}
+
+	w3 := performRequest(h.GetBooks, http.MethodGet, "/books", nil, nil)
+	var second booksResponse
+	if err := json.Unmarshal(w3.Body.Bytes(), &second); err != nil {
+		t.Fatalf("unmarshal failed: %v", err)
+	}
+	if second.Total != 2 {
+		t.Fatalf("expected stale bookList to be invalidated and 2 books returned, got %d", second.Total)
+	}
+}
@@ -0,0 +1,77 @@
+package handlers
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"my-gin-project/events"
+	"my-gin-project/store"
+)
+
+// EventHandler serves the /events routes.
+type EventHandler struct {
+	Events     store.EventStore
+	Subscriber events.Subscriber
+}
+
+// NewEventHandler returns an EventHandler backed by the given event store
+// and subscriber.
+func NewEventHandler(eventStore store.EventStore, subscriber events.Subscriber) *EventHandler {
+	return &EventHandler{Events: eventStore, Subscriber: subscriber}
+}
+
+// ListEvents handles GET /events?since=<unix-seconds>, returning every
+// event recorded after since (defaulting to the epoch, i.e. everything).
+func (h *EventHandler) ListEvents(c *gin.Context) {
+	since := time.Unix(0, 0)
+	if raw := c.Query("since"); raw != "" {
+		secs, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid since timestamp"})
+			return
+		}
+		since = time.Unix(secs, 0)
+	}
+
+	eventList, err := h.Events.ListSince(c.Request.Context(), since)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to list events"})
+		return
+	}
+
+	c.JSON(http.StatusOK, eventList)
+}
+
+// StreamEvents handles GET /events/stream, a Server-Sent Events endpoint
+// that forwards every event published after the client connects.
+func (h *EventHandler) StreamEvents(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	stream, closeFn, err := h.Subscriber.Subscribe(ctx)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to subscribe to events"})
+		return
+	}
+	defer closeFn()
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+
+	c.Stream(func(w io.Writer) bool {
+		select {
+		case event, ok := <-stream:
+			if !ok {
+				return false
+			}
+			c.SSEvent("book_event", event)
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	})
+}
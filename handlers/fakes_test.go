@@ -0,0 +1,120 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"my-gin-project/models"
+	"my-gin-project/store"
+)
+
+// fakeStore is an in-memory store.Store, so handler tests exercise the
+// real CRUD logic (conflict/not-found handling, caching, ...) without
+// spinning up MongoDB.
+type fakeStore struct {
+	books map[primitive.ObjectID]models.Book
+}
+
+func newFakeStore() *fakeStore {
+	return &fakeStore{books: make(map[primitive.ObjectID]models.Book)}
+}
+
+func (s *fakeStore) Create(ctx context.Context, book *models.Book) error {
+	for _, b := range s.books {
+		if b.ISBN == book.ISBN {
+			return store.ErrDuplicateISBN
+		}
+	}
+	book.ID = primitive.NewObjectID()
+	s.books[book.ID] = *book
+	return nil
+}
+
+func (s *fakeStore) Update(ctx context.Context, id primitive.ObjectID, book *models.Book) error {
+	if _, ok := s.books[id]; !ok {
+		return store.ErrNotFound
+	}
+	for existingID, b := range s.books {
+		if existingID != id && b.ISBN == book.ISBN {
+			return store.ErrDuplicateISBN
+		}
+	}
+	book.ID = id
+	s.books[id] = *book
+	return nil
+}
+
+func (s *fakeStore) Get(ctx context.Context, id primitive.ObjectID) (*models.Book, error) {
+	b, ok := s.books[id]
+	if !ok {
+		return nil, store.ErrNotFound
+	}
+	return &b, nil
+}
+
+func (s *fakeStore) GetByISBN(ctx context.Context, isbn string) (*models.Book, error) {
+	for _, b := range s.books {
+		if b.ISBN == isbn {
+			return &b, nil
+		}
+	}
+	return nil, store.ErrNotFound
+}
+
+func (s *fakeStore) GetAll(ctx context.Context, opts store.ListOptions) (*store.ListResult, error) {
+	var books []models.Book
+	for _, b := range s.books {
+		books = append(books, b)
+	}
+	return &store.ListResult{Books: books, Total: int64(len(books))}, nil
+}
+
+func (s *fakeStore) Delete(ctx context.Context, id primitive.ObjectID) error {
+	if _, ok := s.books[id]; !ok {
+		return store.ErrNotFound
+	}
+	delete(s.books, id)
+	return nil
+}
+
+// fakeCache is an in-memory cache.Cache, so handler tests can assert on
+// real caching/invalidation behavior without spinning up Redis.
+type fakeCache struct {
+	data map[string]string
+}
+
+func newFakeCache() *fakeCache {
+	return &fakeCache{data: make(map[string]string)}
+}
+
+func (c *fakeCache) Get(ctx context.Context, key string) (string, error) {
+	v, ok := c.data[key]
+	if !ok {
+		return "", errors.New("cache: miss")
+	}
+	return v, nil
+}
+
+func (c *fakeCache) Set(ctx context.Context, key, value string, ttl time.Duration) error {
+	c.data[key] = value
+	return nil
+}
+
+func (c *fakeCache) Del(ctx context.Context, key string) error {
+	delete(c.data, key)
+	return nil
+}
+
+func (c *fakeCache) Invalidate(ctx context.Context, pattern string) error {
+	prefix := strings.TrimSuffix(pattern, "*")
+	for k := range c.data {
+		if strings.HasPrefix(k, prefix) {
+			delete(c.data, k)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"my-gin-project/store"
+)
+
+// parseListOptions builds store.ListOptions from the query string of a
+// GET /books request: limit, offset, sort_column, sort_order, title, author.
+func parseListOptions(c *gin.Context) store.ListOptions {
+	opts := store.ListOptions{
+		SortColumn:   c.Query("sort_column"),
+		TitleFilter:  c.Query("title"),
+		AuthorFilter: c.Query("author"),
+	}
+
+	if limit, err := strconv.ParseInt(c.Query("limit"), 10, 64); err == nil {
+		opts.Limit = limit
+	}
+	if offset, err := strconv.ParseInt(c.Query("offset"), 10, 64); err == nil {
+		opts.Offset = offset
+	}
+	if c.Query("sort_order") == "desc" {
+		opts.SortOrder = -1
+	} else if c.Query("sort_order") == "asc" {
+		opts.SortOrder = 1
+	}
+
+	return opts
+}
+
+// listCacheKey derives a cache key for bookList that is stable for a given
+// combination of query parameters, so distinct pages/filters/sorts of
+// /books are cached independently instead of colliding on one key.
+func listCacheKey(opts store.ListOptions) string {
+	normalized := fmt.Sprintf("limit=%d&offset=%d&sort_column=%s&sort_order=%d&title=%s&author=%s",
+		opts.Limit, opts.Offset, opts.SortColumn, opts.SortOrder, opts.TitleFilter, opts.AuthorFilter)
+
+	sum := sha256.Sum256([]byte(normalized))
+	return bookListCacheKey + ":" + hex.EncodeToString(sum[:])
+}
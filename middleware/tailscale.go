@@ -0,0 +1,57 @@
+// Package middleware holds gin middleware shared across route groups.
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"tailscale.com/client/tailscale"
+	"tailscale.com/client/tailscale/apitype"
+)
+
+// ActorContextKey is the gin context key under which RequireTailscaleIdentity
+// stores the resolved caller identity, for handlers to attribute writes to.
+const ActorContextKey = "actor"
+
+// RequireTailscaleIdentity resolves the caller's identity by asking the
+// local tailscaled for who owns the connecting peer's address, and rejects
+// any peer whose login name (or, for tagged nodes, whose tags) aren't in
+// allowlist. This gives the service a zero-config way to run on a tailnet
+// where only trusted nodes can reach it, without a JWT/OAuth stack.
+func RequireTailscaleIdentity(local *tailscale.LocalClient, allowlist map[string]bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		who, err := local.WhoIs(c.Request.Context(), c.Request.RemoteAddr)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Unable to resolve Tailscale identity"})
+			return
+		}
+
+		identity, allowed := resolveIdentity(who, allowlist)
+		if !allowed {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Peer is not authorized to perform this action"})
+			return
+		}
+
+		c.Set(ActorContextKey, identity)
+		c.Next()
+	}
+}
+
+// resolveIdentity picks the login name or node tag that matches allowlist,
+// preferring the human identity over tags when both are present.
+func resolveIdentity(who *apitype.WhoIsResponse, allowlist map[string]bool) (string, bool) {
+	if who.UserProfile != nil && allowlist[who.UserProfile.LoginName] {
+		return who.UserProfile.LoginName, true
+	}
+	if who.Node != nil {
+		for _, tag := range who.Node.Tags {
+			if allowlist[tag] {
+				return tag, true
+			}
+		}
+	}
+	if who.UserProfile != nil {
+		return who.UserProfile.LoginName, false
+	}
+	return "", false
+}
@@ -0,0 +1,20 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Book represents a single catalog entry.
+type Book struct {
+	ID          primitive.ObjectID `json:"_id,omitempty" bson:"_id,omitempty"`
+	ISBN        string             `json:"isbn" bson:"isbn" binding:"required,isbn"`
+	Title       string             `json:"title" bson:"title" binding:"required"`
+	Authors     []string           `json:"authors" bson:"authors" binding:"required,min=1"`
+	Publisher   string             `json:"publisher,omitempty" bson:"publisher,omitempty"`
+	Price       float64            `json:"price,omitempty" bson:"price,omitempty"`
+	PublishedAt time.Time          `json:"published_at,omitempty" bson:"published_at,omitempty"`
+	CreatedAt   time.Time          `json:"created_at,omitempty" bson:"created_at,omitempty"`
+	UpdatedAt   time.Time          `json:"updated_at,omitempty" bson:"updated_at,omitempty"`
+}
@@ -0,0 +1,29 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// EventType identifies the kind of change a BookEvent records.
+type EventType string
+
+const (
+	EventCreated EventType = "created"
+	EventUpdated EventType = "updated"
+	EventDeleted EventType = "deleted"
+)
+
+// BookEvent is an append-only audit record of a single write to the
+// catalog, published so downstream consumers (search indexers,
+// notification services) can react to changes instead of polling /books.
+type BookEvent struct {
+	ID        primitive.ObjectID `json:"id,omitempty" bson:"_id,omitempty"`
+	Type      EventType          `json:"type" bson:"type"`
+	BookID    string             `json:"book_id" bson:"book_id"`
+	Actor     string             `json:"actor" bson:"actor"`
+	Timestamp time.Time          `json:"timestamp" bson:"timestamp"`
+	Diff      json.RawMessage    `json:"diff,omitempty" bson:"diff,omitempty"`
+}
@@ -0,0 +1,23 @@
+package models
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+)
+
+// isbnPattern accepts ISBN-10 or ISBN-13 with any hyphens already stripped.
+var isbnPattern = regexp.MustCompile(`^(?:\d{9}[\dXx]|\d{13})$`)
+
+func init() {
+	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
+		_ = v.RegisterValidation("isbn", validateISBN)
+	}
+}
+
+func validateISBN(fl validator.FieldLevel) bool {
+	digits := strings.ReplaceAll(fl.Field().String(), "-", "")
+	return isbnPattern.MatchString(digits)
+}
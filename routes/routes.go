@@ -0,0 +1,34 @@
+// Package routes wires HTTP routes to their handlers.
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"my-gin-project/handlers"
+)
+
+// RegisterPublicBookRoutes attaches the read-only /books endpoints to the
+// public router.
+func RegisterPublicBookRoutes(r *gin.Engine, h *handlers.BookHandler) {
+	r.GET("/books", h.GetBooks)
+	r.GET("/books/search", h.SearchBooks)
+	r.GET("/books/isbn/:isbn", h.GetBookByISBN)
+	r.GET("/books/:id", h.GetBook)
+}
+
+// RegisterAdminBookRoutes attaches the mutating /books endpoints to the
+// admin router, gated behind auth.
+func RegisterAdminBookRoutes(r *gin.Engine, h *handlers.BookHandler, auth gin.HandlerFunc) {
+	admin := r.Group("/books")
+	admin.Use(auth)
+	admin.POST("", h.CreateBook)
+	admin.PUT("/:id", h.UpdateBook)
+	admin.DELETE("/:id", h.DeleteBook)
+}
+
+// RegisterEventRoutes attaches the read-only /events endpoints to the
+// public router.
+func RegisterEventRoutes(r *gin.Engine, h *handlers.EventHandler) {
+	r.GET("/events", h.ListEvents)
+	r.GET("/events/stream", h.StreamEvents)
+}
@@ -0,0 +1,92 @@
+// Package elastic implements search.Indexer on top of Elasticsearch (or a
+// compatible OpenSearch cluster) using olivere/elastic.
+package elastic
+
+import (
+	"context"
+	"encoding/json"
+
+	elastic "github.com/olivere/elastic/v7"
+
+	"my-gin-project/models"
+	"my-gin-project/search"
+)
+
+const indexName = "books"
+
+var mapping = map[string]interface{}{
+	"mappings": map[string]interface{}{
+		"properties": map[string]interface{}{
+			"isbn":      map[string]interface{}{"type": "keyword"},
+			"title":     map[string]interface{}{"type": "text"},
+			"authors":   map[string]interface{}{"type": "text"},
+			"publisher": map[string]interface{}{"type": "text"},
+		},
+	},
+}
+
+// Indexer is a search.Indexer backed by an Elasticsearch/OpenSearch client.
+type Indexer struct {
+	client *elastic.Client
+}
+
+// New returns an Elasticsearch-backed Indexer using the given client.
+func New(client *elastic.Client) *Indexer {
+	return &Indexer{client: client}
+}
+
+func (i *Indexer) EnsureIndex(ctx context.Context) error {
+	exists, err := i.client.IndexExists(indexName).Do(ctx)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return nil
+	}
+	_, err = i.client.CreateIndex(indexName).BodyJson(mapping).Do(ctx)
+	return err
+}
+
+func (i *Indexer) Upsert(ctx context.Context, book models.Book) error {
+	_, err := i.client.Index().Index(indexName).Id(book.ID.Hex()).BodyJson(book).Do(ctx)
+	return err
+}
+
+func (i *Indexer) Delete(ctx context.Context, bookID string) error {
+	_, err := i.client.Delete().Index(indexName).Id(bookID).Do(ctx)
+	if elastic.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+func (i *Indexer) Search(ctx context.Context, query string) (*search.Result, error) {
+	q := elastic.NewMultiMatchQuery(query, "title", "authors", "publisher")
+	highlight := elastic.NewHighlight().Fields(
+		elastic.NewHighlighterField("title"),
+		elastic.NewHighlighterField("authors"),
+		elastic.NewHighlighterField("publisher"),
+	)
+
+	res, err := i.client.Search().Index(indexName).Query(q).Highlight(highlight).Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	hits := make([]search.Hit, 0, len(res.Hits.Hits))
+	for _, h := range res.Hits.Hits {
+		var book models.Book
+		if err := json.Unmarshal(h.Source, &book); err != nil {
+			continue
+		}
+
+		var score float64
+		if h.Score != nil {
+			score = *h.Score
+		}
+
+		hits = append(hits, search.Hit{Book: book, Score: score, Highlights: h.Highlight})
+	}
+
+	return &search.Result{Hits: hits, Total: res.TotalHits()}, nil
+}
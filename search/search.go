@@ -0,0 +1,37 @@
+// Package search defines the full-text search boundary for the catalog,
+// kept separate from store.Store since a search index is an eventually
+// consistent, rebuildable projection rather than the system of record.
+package search
+
+import (
+	"context"
+
+	"my-gin-project/models"
+)
+
+// Hit is a single ranked search result, with highlighted fragments of the
+// fields that matched.
+type Hit struct {
+	Book       models.Book         `json:"book"`
+	Score      float64             `json:"score"`
+	Highlights map[string][]string `json:"highlights,omitempty"`
+}
+
+// Result is a ranked page of search hits.
+type Result struct {
+	Hits  []Hit `json:"hits"`
+	Total int64 `json:"total"`
+}
+
+// Indexer is the pluggable full-text search backend for the catalog.
+type Indexer interface {
+	// EnsureIndex creates the index and its mapping if they don't already
+	// exist, so startup is idempotent against a fresh search cluster.
+	EnsureIndex(ctx context.Context) error
+	// Upsert indexes or reindexes a book.
+	Upsert(ctx context.Context, book models.Book) error
+	// Delete removes a book from the index.
+	Delete(ctx context.Context, bookID string) error
+	// Search ranks books by relevance to query over title/authors/publisher.
+	Search(ctx context.Context, query string) (*Result, error)
+}
@@ -0,0 +1,15 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"my-gin-project/models"
+)
+
+// EventStore is the persistence boundary for the book-events audit stream.
+// It is append-only: events are never updated or deleted.
+type EventStore interface {
+	Append(ctx context.Context, event *models.BookEvent) error
+	ListSince(ctx context.Context, since time.Time) ([]models.BookEvent, error)
+}
@@ -0,0 +1,70 @@
+// Package factory builds a store.Store from a configured provider name,
+// so main only needs to know the name of the backend it wants, not how to
+// construct it.
+package factory
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"my-gin-project/configs"
+	"my-gin-project/store"
+	mongostore "my-gin-project/store/mongo"
+)
+
+// Provider constructs a Store for a given configuration.
+type Provider func(ctx context.Context, cfg *configs.Config) (store.Store, error)
+
+var providers = map[string]Provider{
+	"mongo": newMongoStore,
+}
+
+// mongoClientOnce lazily dials the shared Mongo client the "mongo" provider
+// and MongoClient both use, so a process talks to the deployment over a
+// single connection no matter how many collections it needs.
+var (
+	mongoClientOnce sync.Once
+	mongoClient     *mongo.Client
+	mongoClientErr  error
+)
+
+// Register adds a named provider, so additional backends (in-memory, SQL,
+// ...) can be wired in without this package knowing about them up front.
+func Register(name string, p Provider) {
+	providers[name] = p
+}
+
+// New constructs the Store registered under name.
+func New(ctx context.Context, name string, cfg *configs.Config) (store.Store, error) {
+	p, ok := providers[name]
+	if !ok {
+		return nil, fmt.Errorf("store: unknown provider %q", name)
+	}
+	return p(ctx, cfg)
+}
+
+// MongoClient returns the Mongo client backing the "mongo" store provider,
+// dialing it on first use. Callers that need another collection on the
+// same deployment (e.g. main wiring up the book_events collection) should
+// use this instead of opening a second connection.
+func MongoClient(cfg *configs.Config) (*mongo.Client, error) {
+	mongoClientOnce.Do(func() {
+		mongoClient, mongoClientErr = configs.ConnectDB(cfg.MongoURI)
+	})
+	return mongoClient, mongoClientErr
+}
+
+func newMongoStore(ctx context.Context, cfg *configs.Config) (store.Store, error) {
+	client, err := MongoClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+	collection := configs.GetCollection(client, cfg.MongoDatabase, "books")
+	if err := mongostore.EnsureIndexes(ctx, collection); err != nil {
+		return nil, err
+	}
+	return mongostore.New(collection), nil
+}
@@ -0,0 +1,53 @@
+package mongo
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	mongodriver "go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"my-gin-project/models"
+)
+
+// EventStore is a store.EventStore backed by an append-only MongoDB
+// collection.
+type EventStore struct {
+	collection *mongodriver.Collection
+}
+
+// NewEventStore returns a Mongo-backed EventStore writing to the given
+// collection.
+func NewEventStore(collection *mongodriver.Collection) *EventStore {
+	return &EventStore{collection: collection}
+}
+
+func (s *EventStore) Append(ctx context.Context, event *models.BookEvent) error {
+	res, err := s.collection.InsertOne(ctx, event)
+	if err != nil {
+		return err
+	}
+	event.ID = res.InsertedID.(primitive.ObjectID)
+	return nil
+}
+
+func (s *EventStore) ListSince(ctx context.Context, since time.Time) ([]models.BookEvent, error) {
+	findOpts := options.Find().SetSort(bson.D{{Key: "timestamp", Value: 1}})
+	cursor, err := s.collection.Find(ctx, bson.M{"timestamp": bson.M{"$gt": since}}, findOpts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var events []models.BookEvent
+	for cursor.Next(ctx) {
+		var event models.BookEvent
+		if err := cursor.Decode(&event); err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, cursor.Err()
+}
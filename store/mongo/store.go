@@ -0,0 +1,166 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	mongodriver "go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"my-gin-project/models"
+	"my-gin-project/store"
+)
+
+// sortableColumns allow-lists the fields GetAll may sort on, so a caller
+// can't sort on an arbitrary (or internal) field by passing any
+// sort_column; other values fall back to "_id". Only _id is indexed among
+// these fields.
+var sortableColumns = map[string]bool{
+	"title":      true,
+	"authors":    true,
+	"price":      true,
+	"created_at": true,
+	"_id":        true,
+}
+
+// Store is a store.Store backed by a MongoDB collection.
+type Store struct {
+	collection *mongodriver.Collection
+}
+
+// New returns a Mongo-backed Store that reads and writes the given collection.
+func New(collection *mongodriver.Collection) *Store {
+	return &Store{collection: collection}
+}
+
+// EnsureIndexes creates the indexes the Store relies on, notably a unique
+// index on isbn so duplicate catalog entries are rejected by MongoDB itself.
+func EnsureIndexes(ctx context.Context, collection *mongodriver.Collection) error {
+	_, err := collection.Indexes().CreateOne(ctx, mongodriver.IndexModel{
+		Keys:    bson.D{{Key: "isbn", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	return err
+}
+
+func (s *Store) Create(ctx context.Context, book *models.Book) error {
+	now := time.Now()
+	book.CreatedAt = now
+	book.UpdatedAt = now
+
+	res, err := s.collection.InsertOne(ctx, book)
+	if err != nil {
+		if mongodriver.IsDuplicateKeyError(err) {
+			return store.ErrDuplicateISBN
+		}
+		return err
+	}
+	book.ID = res.InsertedID.(primitive.ObjectID)
+	return nil
+}
+
+func (s *Store) Update(ctx context.Context, id primitive.ObjectID, book *models.Book) error {
+	book.ID = id
+	book.UpdatedAt = time.Now()
+
+	res, err := s.collection.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": book})
+	if err != nil {
+		if mongodriver.IsDuplicateKeyError(err) {
+			return store.ErrDuplicateISBN
+		}
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return store.ErrNotFound
+	}
+	return nil
+}
+
+func (s *Store) Get(ctx context.Context, id primitive.ObjectID) (*models.Book, error) {
+	var book models.Book
+	if err := s.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&book); err != nil {
+		if errors.Is(err, mongodriver.ErrNoDocuments) {
+			return nil, store.ErrNotFound
+		}
+		return nil, err
+	}
+	return &book, nil
+}
+
+func (s *Store) GetByISBN(ctx context.Context, isbn string) (*models.Book, error) {
+	var book models.Book
+	if err := s.collection.FindOne(ctx, bson.M{"isbn": isbn}).Decode(&book); err != nil {
+		if errors.Is(err, mongodriver.ErrNoDocuments) {
+			return nil, store.ErrNotFound
+		}
+		return nil, err
+	}
+	return &book, nil
+}
+
+func (s *Store) GetAll(ctx context.Context, opts store.ListOptions) (*store.ListResult, error) {
+	filter := bson.M{}
+	if opts.TitleFilter != "" {
+		filter["title"] = bson.M{"$regex": regexp.QuoteMeta(opts.TitleFilter), "$options": "i"}
+	}
+	if opts.AuthorFilter != "" {
+		filter["authors"] = bson.M{"$regex": regexp.QuoteMeta(opts.AuthorFilter), "$options": "i"}
+	}
+
+	total, err := s.collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	sortColumn := opts.SortColumn
+	if !sortableColumns[sortColumn] {
+		sortColumn = "_id"
+	}
+	sortOrder := opts.SortOrder
+	if sortOrder == 0 {
+		sortOrder = 1
+	}
+
+	findOpts := options.Find().SetSort(bson.D{{Key: sortColumn, Value: sortOrder}})
+	if opts.Limit > 0 {
+		findOpts.SetLimit(opts.Limit)
+	}
+	if opts.Offset > 0 {
+		findOpts.SetSkip(opts.Offset)
+	}
+
+	cursor, err := s.collection.Find(ctx, filter, findOpts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var books []models.Book
+	for cursor.Next(ctx) {
+		var book models.Book
+		if err := cursor.Decode(&book); err != nil {
+			return nil, err
+		}
+		books = append(books, book)
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	return &store.ListResult{Books: books, Total: total}, nil
+}
+
+func (s *Store) Delete(ctx context.Context, id primitive.ObjectID) error {
+	res, err := s.collection.DeleteOne(ctx, bson.M{"_id": id})
+	if err != nil {
+		return err
+	}
+	if res.DeletedCount == 0 {
+		return store.ErrNotFound
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package store
+
+import (
+	"context"
+	"errors"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"my-gin-project/models"
+)
+
+// ErrDuplicateISBN is returned by Create/Update when the book's ISBN
+// collides with an existing catalog entry.
+var ErrDuplicateISBN = errors.New("store: duplicate ISBN")
+
+// ErrNotFound is returned by Get/GetByISBN/Update/Delete when no book
+// matches. Providers translate their own not-found signal (e.g.
+// mongo.ErrNoDocuments) into this sentinel so handlers never need to
+// depend on a concrete driver to tell "not found" apart from other errors.
+var ErrNotFound = errors.New("store: not found")
+
+// ListOptions controls pagination, sorting and filtering for GetAll.
+type ListOptions struct {
+	Limit        int64
+	Offset       int64
+	SortColumn   string // defaults to "_id" when empty
+	SortOrder    int    // 1 for ascending, -1 for descending; defaults to 1
+	TitleFilter  string // case-insensitive substring match
+	AuthorFilter string // case-insensitive substring match
+}
+
+// ListResult is a page of books plus the total number of books matching the
+// filter, so callers can render client-side paging controls.
+type ListResult struct {
+	Books []models.Book
+	Total int64
+}
+
+// Store is the persistence boundary for the book catalog. Handlers depend
+// only on this interface, never on a concrete database client, so the
+// backing provider (MongoDB, an in-memory fake for tests, SQL, ...) can be
+// swapped via store/factory.
+type Store interface {
+	Create(ctx context.Context, book *models.Book) error
+	Update(ctx context.Context, id primitive.ObjectID, book *models.Book) error
+	Get(ctx context.Context, id primitive.ObjectID) (*models.Book, error)
+	GetByISBN(ctx context.Context, isbn string) (*models.Book, error)
+	GetAll(ctx context.Context, opts ListOptions) (*ListResult, error)
+	Delete(ctx context.Context, id primitive.ObjectID) error
+}